@@ -0,0 +1,56 @@
+// Command val is the CLI entrypoint for one-off runs: fetch, evaluate and
+// publish a batch of tickers without standing up the vald HTTP server.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"val/internal/output"
+	"val/internal/pipeline"
+)
+
+func main() {
+	adapter := flag.String("adapter", "mock", "adapter to fetch rows from")
+	strategy := flag.String("strategy", "dcf", "comma-separated list of strategies to evaluate")
+	tickers := flag.String("tickers", "", "comma-separated list of tickers")
+	mode := flag.String("mode", string(output.ModeConsole), "output mode: console or broadcast")
+	broker := flag.String("broker", "", `broadcast broker ("nats", "stdout", "noop"); defaults to "nats"`)
+	format := flag.String("format", "", `console formatter ("table", "json", "ndjson", "csv", "markdown"); defaults to "table"`)
+	out := flag.String("output", "", `console output ("file:path" writes to that path; defaults to stdout)`)
+	timeout := flag.Duration("timeout", 0, "per-ticker fetch/eval timeout (0 = no deadline)")
+	maxConcurrency := flag.Int("max-concurrency", 0, "max concurrent adapter fetches (0 = pipeline default of 4)")
+	refresh := flag.Bool("refresh", false, "bypass the cache and force fresh fetches/evals")
+	flag.Parse()
+
+	if *tickers == "" {
+		fmt.Fprintln(os.Stderr, "val: -tickers is required")
+		os.Exit(2)
+	}
+
+	opts := pipeline.Options{
+		Mode:           output.Mode(*mode),
+		Adapter:        *adapter,
+		Strategy:       *strategy,
+		TickersCSV:     *tickers,
+		Broker:         *broker,
+		Timeout:        *timeout,
+		MaxConcurrency: *maxConcurrency,
+		Format:         *format,
+		Output:         *out,
+		Refresh:        *refresh,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	start := time.Now()
+	if err := pipeline.Run(ctx, opts); err != nil {
+		log.Fatalf("val: %v", err)
+	}
+	log.Printf("val: done in %s", time.Since(start))
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"log"
+	"net/http"
+
+	"val/internal/adapters"
+	"val/internal/pipeline"
+	"val/internal/strategies"
+)
+
+//go:embed static
+var staticFS embed.FS
+
+// mountGUI wires the run/discovery API and the embedded SPA onto mux,
+// alongside the /health endpoint main() already registers.
+func mountGUI(mux *http.ServeMux) {
+	mux.HandleFunc("/api/run", handleRun)
+	mux.HandleFunc("/api/adapters", handleAdapters)
+	mux.HandleFunc("/api/strategies", handleStrategies)
+
+	sub, err := fs.Sub(staticFS, "static")
+	if err != nil {
+		log.Fatalf("gui: embedded static assets: %v", err)
+	}
+	mux.Handle("/", http.FileServer(http.FS(sub)))
+}
+
+func handleAdapters(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"adapters": adapters.Names()})
+}
+
+func handleStrategies(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"strategies": strategies.KnownStrategies})
+}
+
+// handleRun accepts a pipeline.Options body and streams each computed row
+// back as a Server-Sent Event, so the SPA can render results as they land
+// instead of waiting for the whole batch.
+func handleRun(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var opts pipeline.Options
+	if err := json.NewDecoder(r.Body).Decode(&opts); err != nil {
+		http.Error(w, "invalid options: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	err := pipeline.RunStream(r.Context(), opts, func(row map[string]any) {
+		payload, err := json.Marshal(row)
+		if err != nil {
+			return
+		}
+		fmt.Fprintf(w, "event: row\ndata: %s\n\n", payload)
+		flusher.Flush()
+	})
+	if err != nil {
+		msg, _ := json.Marshal(map[string]string{"error": err.Error()})
+		fmt.Fprintf(w, "event: error\ndata: %s\n\n", msg)
+		flusher.Flush()
+		return
+	}
+	fmt.Fprint(w, "event: done\ndata: {}\n\n")
+	flusher.Flush()
+}
@@ -5,6 +5,8 @@ import (
 	"log"
 	"net/http"
 	"os"
+
+	"val/internal/pipeline"
 )
 
 func health(w http.ResponseWriter, r *http.Request) {
@@ -13,12 +15,14 @@ func health(w http.ResponseWriter, r *http.Request) {
 		"status":  "ok",
 		"service": "vald",
 		"version": "0.0.1",
+		"cache":   pipeline.CacheMetrics(),
 	})
 }
 
 func main() {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/health", health)
+	mountGUI(mux)
 
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -1,9 +1,25 @@
 package adapters
 
+import "context"
+
+// Row is a single fetched record, keyed by field name.
+type Row = map[string]any
+
 type Adapter interface {
 	Name() string
 	Fields() []string
-	Fetch(tickers []string) ([]map[string]any, error)
+	// Fetch is the legacy synchronous entry point. It has no cancellation
+	// or per-call deadline support; prefer FetchCtx via the FetchCtx
+	// package function, which falls back to this for adapters that don't
+	// implement CtxFetcher.
+	Fetch(tickers []string) ([]Row, error)
+}
+
+// CtxFetcher is implemented by adapters that can stream rows natively and
+// honor ctx cancellation/deadlines. Adapters that only implement Fetch are
+// wrapped with a synchronous shim by the package-level FetchCtx.
+type CtxFetcher interface {
+	FetchCtx(ctx context.Context, tickers []string) (<-chan Row, error)
 }
 
 var registry = map[string]Adapter{}
@@ -19,3 +35,32 @@ func Names() []string {
 	}
 	return out
 }
+
+// FetchCtx streams a's rows onto a channel, cancelable via ctx. Adapters
+// implementing CtxFetcher are called directly; others fall back to a shim
+// that runs Fetch synchronously and forwards its rows.
+func FetchCtx(ctx context.Context, a Adapter, tickers []string) (<-chan Row, error) {
+	if cf, ok := a.(CtxFetcher); ok {
+		return cf.FetchCtx(ctx, tickers)
+	}
+	return fetchCtxShim(ctx, a, tickers)
+}
+
+func fetchCtxShim(ctx context.Context, a Adapter, tickers []string) (<-chan Row, error) {
+	rows, err := a.Fetch(tickers)
+	if err != nil {
+		return nil, err
+	}
+	out := make(chan Row)
+	go func() {
+		defer close(out)
+		for _, r := range rows {
+			select {
+			case out <- r:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
@@ -0,0 +1,41 @@
+// Package broker abstracts the message bus that BroadcastSink publishes
+// valuation events to. It mirrors the adapters package's registry pattern so
+// callers can select an implementation by name at runtime.
+package broker
+
+import "fmt"
+
+// Broker publishes raw payloads to a named subject and can be drained and
+// torn down cleanly when a run finishes.
+type Broker interface {
+	Name() string
+	Publish(subject string, payload []byte) error
+	Flush() error
+	Close() error
+}
+
+type factory func() (Broker, error)
+
+var registry = map[string]factory{}
+
+// Register adds a broker factory under name. Later calls with the same name
+// overwrite earlier ones, matching adapters.Register.
+func Register(name string, f factory) { registry[name] = f }
+
+// Get constructs the broker registered under name.
+func Get(name string) (Broker, error) {
+	f, ok := registry[name]
+	if !ok {
+		return nil, fmt.Errorf("broker not found: %s", name)
+	}
+	return f()
+}
+
+// Names returns the registered broker names.
+func Names() []string {
+	out := make([]string, 0, len(registry))
+	for k := range registry {
+		out = append(out, k)
+	}
+	return out
+}
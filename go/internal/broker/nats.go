@@ -0,0 +1,52 @@
+package broker
+
+import (
+	"os"
+
+	"github.com/nats-io/nats.go"
+)
+
+// defaultNATSURL is used when BROKER_URL is unset, matching nats.go's own
+// default so local `nats-server` instances work out of the box.
+const defaultNATSURL = nats.DefaultURL
+
+// natsBroker publishes over a single NATS connection. It's the default
+// broker for the broadcast sink.
+type natsBroker struct {
+	conn *nats.Conn
+}
+
+func (n *natsBroker) Name() string { return "nats" }
+
+func (n *natsBroker) Publish(subject string, payload []byte) error {
+	return n.conn.Publish(subject, payload)
+}
+
+func (n *natsBroker) Flush() error {
+	return n.conn.Flush()
+}
+
+func (n *natsBroker) Close() error {
+	if err := n.conn.Flush(); err != nil {
+		n.conn.Close()
+		return err
+	}
+	n.conn.Close()
+	return nil
+}
+
+func newNATSBroker() (Broker, error) {
+	url := os.Getenv("BROKER_URL")
+	if url == "" {
+		url = defaultNATSURL
+	}
+	conn, err := nats.Connect(url)
+	if err != nil {
+		return nil, err
+	}
+	return &natsBroker{conn: conn}, nil
+}
+
+func init() {
+	Register("nats", newNATSBroker)
+}
@@ -0,0 +1,14 @@
+package broker
+
+// noop discards every publish. Useful for dry runs and benchmarking the
+// pipeline without a live broker.
+type noop struct{}
+
+func (n *noop) Name() string                           { return "noop" }
+func (n *noop) Publish(subject string, payload []byte) error { return nil }
+func (n *noop) Flush() error                            { return nil }
+func (n *noop) Close() error                            { return nil }
+
+func init() {
+	Register("noop", func() (Broker, error) { return &noop{}, nil })
+}
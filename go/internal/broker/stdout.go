@@ -0,0 +1,22 @@
+package broker
+
+import "fmt"
+
+// stdoutBroker writes each publish as a line to stdout. It's not the
+// default (nats is); select it explicitly via Options.Broker when
+// debugging the broadcast sink locally without standing up NATS.
+type stdoutBroker struct{}
+
+func (s *stdoutBroker) Name() string { return "stdout" }
+
+func (s *stdoutBroker) Publish(subject string, payload []byte) error {
+	fmt.Printf("[%s] %s\n", subject, payload)
+	return nil
+}
+
+func (s *stdoutBroker) Flush() error { return nil }
+func (s *stdoutBroker) Close() error { return nil }
+
+func init() {
+	Register("stdout", func() (Broker, error) { return &stdoutBroker{}, nil })
+}
@@ -0,0 +1,60 @@
+package cache
+
+import (
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var bucketName = []byte("cache")
+
+// Bolt is a Store backed by an embedded BoltDB file, for persisting cache
+// state across restarts of a single vald process without standing up a
+// separate cache service. It doesn't track hit/miss/eviction metrics, and
+// Set ignores ttl: stored entries never expire.
+type Bolt struct {
+	db *bolt.DB
+}
+
+// NewBolt opens (creating if needed) a BoltDB file at path.
+func NewBolt(path string) (*Bolt, error) {
+	db, err := bolt.Open(path, 0o600, nil)
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(bucketName)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &Bolt{db: db}, nil
+}
+
+func (b *Bolt) Get(key string) ([]byte, bool) {
+	var value []byte
+	_ = b.db.View(func(tx *bolt.Tx) error {
+		if v := tx.Bucket(bucketName).Get([]byte(key)); v != nil {
+			value = append([]byte(nil), v...)
+		}
+		return nil
+	})
+	return value, value != nil
+}
+
+func (b *Bolt) Set(key string, value []byte, ttl time.Duration) {
+	_ = b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketName).Put([]byte(key), value)
+	})
+}
+
+func (b *Bolt) Len() int {
+	n := 0
+	_ = b.db.View(func(tx *bolt.Tx) error {
+		n = tx.Bucket(bucketName).Stats().KeyN
+		return nil
+	})
+	return n
+}
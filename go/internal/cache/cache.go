@@ -0,0 +1,115 @@
+// Package cache sits between adapters/strategies and their callers,
+// short-circuiting repeat lookups for the same (source, ticker, field-set,
+// day) and collapsing concurrent misses into one upstream call.
+package cache
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// Store is the pluggable cache backend. The in-memory LRU is the default;
+// Redis and BoltDB back ends share the same interface so a deployment can
+// swap in shared or persistent storage without touching callers.
+type Store interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte, ttl time.Duration)
+	Len() int
+}
+
+// Metrics summarizes a Store's hit rate and churn, surfaced on /health.
+type Metrics struct {
+	Hits      int64 `json:"hits"`
+	Misses    int64 `json:"misses"`
+	Evictions int64 `json:"evictions"`
+	Size      int   `json:"size"`
+}
+
+// MetricsProvider is implemented by stores that track Metrics (the LRU
+// does; Redis and Bolt don't track hits/misses themselves and report a
+// zero value).
+type MetricsProvider interface {
+	Metrics() Metrics
+}
+
+// defaultTTL is used for any namespace without an explicit entry in a
+// Cache's ttls map.
+const defaultTTL = 15 * time.Minute
+
+// Cache wraps a Store with per-namespace TTLs and singleflight stampede
+// protection, so N concurrent callers asking for the same key during a
+// miss trigger exactly one load.
+type Cache struct {
+	store Store
+	group singleflight.Group
+	ttls  map[string]time.Duration
+}
+
+// New builds a Cache over store. ttls maps a namespace (an adapter or
+// strategy name) to its TTL; namespaces not present use defaultTTL.
+func New(store Store, ttls map[string]time.Duration) *Cache {
+	return &Cache{store: store, ttls: ttls}
+}
+
+func (c *Cache) ttlFor(namespace string) time.Duration {
+	if ttl, ok := c.ttls[namespace]; ok {
+		return ttl
+	}
+	return defaultTTL
+}
+
+// GetOrLoad returns the cached bytes under key, calling load and caching
+// the result under namespace's TTL on a miss. Concurrent GetOrLoad calls
+// for the same key share one load and one Store.Get, so the hit/miss
+// counters Store tracks aren't double-counted per call.
+func (c *Cache) GetOrLoad(namespace, key string, load func() ([]byte, error)) ([]byte, error) {
+	v, err, _ := c.group.Do(key, func() (any, error) {
+		if v, ok := c.store.Get(key); ok {
+			return v, nil
+		}
+		v, err := load()
+		if err != nil {
+			return nil, err
+		}
+		c.store.Set(key, v, c.ttlFor(namespace))
+		return v, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]byte), nil
+}
+
+// Get looks up key directly, without triggering a load on a miss. Useful
+// when a caller wants to check the cache before deciding whether a load is
+// even needed.
+func (c *Cache) Get(key string) ([]byte, bool) {
+	return c.store.Get(key)
+}
+
+// Set stores value under key using namespace's TTL.
+func (c *Cache) Set(namespace, key string, value []byte) {
+	c.store.Set(key, value, c.ttlFor(namespace))
+}
+
+// Metrics reports the underlying store's hit/miss/eviction counters, or a
+// zero value for stores that don't track them.
+func (c *Cache) Metrics() Metrics {
+	if mp, ok := c.store.(MetricsProvider); ok {
+		return mp.Metrics()
+	}
+	return Metrics{}
+}
+
+// Key builds a cache key from a namespace (adapter or strategy name), a
+// ticker, the field set involved, and the UTC calendar day, so entries
+// naturally expire across day boundaries regardless of TTL.
+func Key(namespace, ticker string, fields []string, day time.Time) string {
+	sorted := append([]string(nil), fields...)
+	sort.Strings(sorted)
+	return fmt.Sprintf("%s|%s|%s|%s", namespace, ticker, strings.Join(sorted, ","), day.UTC().Format("2006-01-02"))
+}
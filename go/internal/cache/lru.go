@@ -0,0 +1,94 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+type lruEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// LRU is an in-memory, size-bounded Store with per-entry TTL. It's the
+// default backend.
+type LRU struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	items    map[string]*list.Element
+
+	hits, misses, evictions int64
+}
+
+// NewLRU builds an LRU holding at most capacity entries; capacity <= 0
+// falls back to a sensible default.
+func NewLRU(capacity int) *LRU {
+	if capacity <= 0 {
+		capacity = 4096
+	}
+	return &LRU{capacity: capacity, ll: list.New(), items: map[string]*list.Element{}}
+}
+
+func (c *LRU) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		c.misses++
+		return nil, false
+	}
+	e := el.Value.(*lruEntry)
+	if !e.expiresAt.IsZero() && time.Now().After(e.expiresAt) {
+		c.ll.Remove(el)
+		delete(c.items, key)
+		c.misses++
+		return nil, false
+	}
+	c.ll.MoveToFront(el)
+	c.hits++
+	return e.value, true
+}
+
+func (c *LRU) Set(key string, value []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+
+	if el, ok := c.items[key]; ok {
+		e := el.Value.(*lruEntry)
+		e.value, e.expiresAt = value, expiresAt
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	el := c.ll.PushFront(&lruEntry{key: key, value: value, expiresAt: expiresAt})
+	c.items[key] = el
+	if c.ll.Len() > c.capacity {
+		back := c.ll.Back()
+		if back != nil {
+			c.ll.Remove(back)
+			delete(c.items, back.Value.(*lruEntry).key)
+			c.evictions++
+		}
+	}
+}
+
+func (c *LRU) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+func (c *LRU) Metrics() Metrics {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return Metrics{Hits: c.hits, Misses: c.misses, Evictions: c.evictions, Size: c.ll.Len()}
+}
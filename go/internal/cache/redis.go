@@ -0,0 +1,35 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Redis is a Store backed by a shared Redis instance, for deployments that
+// run more than one vald process and want them to share cache state.
+type Redis struct {
+	client *redis.Client
+}
+
+// NewRedis connects to a Redis instance at addr (host:port).
+func NewRedis(addr string) *Redis {
+	return &Redis{client: redis.NewClient(&redis.Options{Addr: addr})}
+}
+
+func (r *Redis) Get(key string) ([]byte, bool) {
+	v, err := r.client.Get(context.Background(), key).Bytes()
+	if err != nil {
+		return nil, false
+	}
+	return v, true
+}
+
+func (r *Redis) Set(key string, value []byte, ttl time.Duration) {
+	r.client.Set(context.Background(), key, value, ttl)
+}
+
+func (r *Redis) Len() int {
+	return int(r.client.DBSize(context.Background()).Val())
+}
@@ -0,0 +1,71 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"val/internal/broker"
+)
+
+// fairValueSubject returns the per-ticker subject a row is published under.
+func fairValueSubject(ticker string) string {
+	return fmt.Sprintf("val.fair.%s", ticker)
+}
+
+const runSummarySubject = "val.run.summary"
+
+// runSummary is the batched event published once after every row has been
+// sent, so subscribers can detect completion without counting subjects.
+type runSummary struct {
+	Count     int       `json:"count"`
+	Tickers   []string  `json:"tickers"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// BroadcastSink publishes each valuation row as an event on a message
+// broker, one subject per ticker, followed by a run summary event.
+type BroadcastSink struct {
+	b broker.Broker
+}
+
+// NewBroadcastSink wires a BroadcastSink to the named broker implementation
+// (e.g. "nats", "stdout", "noop").
+func NewBroadcastSink(brokerName string) (*BroadcastSink, error) {
+	b, err := broker.Get(brokerName)
+	if err != nil {
+		return nil, err
+	}
+	return &BroadcastSink{b: b}, nil
+}
+
+func (s *BroadcastSink) Publish(items []map[string]any) error {
+	defer s.b.Close()
+
+	tickers := make([]string, 0, len(items))
+	for _, it := range items {
+		t, _ := it["ticker"].(string)
+		if t == "" {
+			continue
+		}
+		payload, err := json.Marshal(it)
+		if err != nil {
+			return err
+		}
+		if err := s.b.Publish(fairValueSubject(t), payload); err != nil {
+			return err
+		}
+		tickers = append(tickers, t)
+	}
+
+	summary := runSummary{Count: len(tickers), Tickers: tickers, Timestamp: time.Now()}
+	payload, err := json.Marshal(summary)
+	if err != nil {
+		return err
+	}
+	if err := s.b.Publish(runSummarySubject, payload); err != nil {
+		return err
+	}
+
+	return s.b.Flush()
+}
@@ -0,0 +1,39 @@
+package output
+
+import "sort"
+
+// baseColumnOrder is the fixed lead-in column order every formatter uses
+// before any adapter/strategy-specific extras.
+var baseColumnOrder = []string{"ticker", "price", "fair_value", "mos", "conf", "strategy", "notes", "agreement"}
+
+// orderedColumns returns the columns present across items, with
+// baseColumnOrder first (only the ones actually present) followed by any
+// remaining columns in alphabetical order. This keeps output stable across
+// runs regardless of map iteration order.
+func orderedColumns(items []map[string]any) []string {
+	present := map[string]bool{}
+	for _, it := range items {
+		for k := range it {
+			present[k] = true
+		}
+	}
+
+	cols := make([]string, 0, len(present))
+	seen := map[string]bool{}
+	for _, c := range baseColumnOrder {
+		if present[c] {
+			cols = append(cols, c)
+			seen[c] = true
+		}
+	}
+
+	extras := make([]string, 0)
+	for k := range present {
+		if !seen[k] {
+			extras = append(extras, k)
+		}
+	}
+	sort.Strings(extras)
+
+	return append(cols, extras...)
+}
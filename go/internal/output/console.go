@@ -2,37 +2,33 @@ package output
 
 import (
 	"fmt"
-	"sort"
+	"io"
+	"os"
 )
 
-type ConsoleSink struct{}
+// ConsoleSink renders rows through a Formatter to an io.Writer (stdout by
+// default, or a file when constructed via ResolveWriter).
+type ConsoleSink struct {
+	w         io.Writer
+	formatter Formatter
+}
 
-func NewConsoleSink() *ConsoleSink { return &ConsoleSink{} }
+// NewConsoleSink builds a ConsoleSink. A nil formatter defaults to
+// TableFormatter; a nil writer defaults to os.Stdout.
+func NewConsoleSink(formatter Formatter, w io.Writer) *ConsoleSink {
+	if formatter == nil {
+		formatter = TableFormatter{}
+	}
+	if w == nil {
+		w = os.Stdout
+	}
+	return &ConsoleSink{w: w, formatter: formatter}
+}
 
 func (c *ConsoleSink) Publish(items []map[string]any) error {
 	if len(items) == 0 {
-		fmt.Println("no results")
+		fmt.Fprintln(c.w, "no results")
 		return nil
 	}
-	cols := make([]string, 0)
-	seen := map[string]bool{}
-	for _, it := range items {
-		for k := range it {
-			if !seen[k] {
-				seen[k] = true
-				cols = append(cols, k)
-			}
-		}
-	}
-	sort.Strings(cols)
-	fmt.Println("----- VAL RESULTS (console mode) -----")
-	fmt.Println(cols)
-	for _, it := range items {
-		row := make([]any, len(cols))
-		for i, k := range cols {
-			row[i] = it[k]
-		}
-		fmt.Println(row...)
-	}
-	return nil
+	return c.formatter.Format(c.w, items)
 }
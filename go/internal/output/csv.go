@@ -0,0 +1,31 @@
+package output
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// CSVFormatter writes the stable column order as a CSV header followed by
+// one row per item.
+type CSVFormatter struct{}
+
+func (CSVFormatter) Format(w io.Writer, items []map[string]any) error {
+	cols := orderedColumns(items)
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write(cols); err != nil {
+		return err
+	}
+	for _, it := range items {
+		row := make([]string, len(cols))
+		for i, c := range cols {
+			row[i] = formatCell(c, it[c])
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
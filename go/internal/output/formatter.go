@@ -0,0 +1,44 @@
+package output
+
+import (
+	"fmt"
+	"io"
+)
+
+// Formatter renders a batch of rows to w.
+type Formatter interface {
+	Format(w io.Writer, items []map[string]any) error
+}
+
+// FormatterFor resolves Options.Format into a Formatter. "" defaults to
+// TableFormatter.
+func FormatterFor(name string) (Formatter, error) {
+	switch name {
+	case "", "table":
+		return TableFormatter{}, nil
+	case "json":
+		return JSONFormatter{}, nil
+	case "ndjson":
+		return NDJSONFormatter{}, nil
+	case "csv":
+		return CSVFormatter{}, nil
+	case "markdown", "md":
+		return MarkdownFormatter{}, nil
+	default:
+		return nil, fmt.Errorf("unknown output format: %s", name)
+	}
+}
+
+// formatCell renders a single cell value, rendering mos as a human percent
+// and missing values as an empty string.
+func formatCell(col string, v any) string {
+	if v == nil {
+		return ""
+	}
+	if col == "mos" {
+		if f, ok := v.(float64); ok {
+			return fmt.Sprintf("%.1f%%", f*100)
+		}
+	}
+	return fmt.Sprint(v)
+}
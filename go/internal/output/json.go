@@ -0,0 +1,29 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// JSONFormatter writes the whole batch as one pretty-printed JSON array.
+type JSONFormatter struct{}
+
+func (JSONFormatter) Format(w io.Writer, items []map[string]any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(items)
+}
+
+// NDJSONFormatter writes one compact JSON object per line, for streaming
+// consumers that don't want to buffer a whole array.
+type NDJSONFormatter struct{}
+
+func (NDJSONFormatter) Format(w io.Writer, items []map[string]any) error {
+	enc := json.NewEncoder(w)
+	for _, it := range items {
+		if err := enc.Encode(it); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// MarkdownFormatter renders a GitHub-flavored Markdown table.
+type MarkdownFormatter struct{}
+
+func (MarkdownFormatter) Format(w io.Writer, items []map[string]any) error {
+	cols := orderedColumns(items)
+
+	fmt.Fprintf(w, "| %s |\n", strings.Join(cols, " | "))
+
+	seps := make([]string, len(cols))
+	for i := range seps {
+		seps[i] = "---"
+	}
+	fmt.Fprintf(w, "| %s |\n", strings.Join(seps, " | "))
+
+	for _, it := range items {
+		cells := make([]string, len(cols))
+		for i, c := range cols {
+			cells[i] = formatCell(c, it[c])
+		}
+		fmt.Fprintf(w, "| %s |\n", strings.Join(cells, " | "))
+	}
+	return nil
+}
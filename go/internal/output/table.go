@@ -0,0 +1,44 @@
+package output
+
+import (
+	"fmt"
+	"io"
+)
+
+// TableFormatter renders aligned, space-padded columns — the historical
+// console output shape.
+type TableFormatter struct{}
+
+func (TableFormatter) Format(w io.Writer, items []map[string]any) error {
+	cols := orderedColumns(items)
+	widths := make([]int, len(cols))
+	for i, c := range cols {
+		widths[i] = len(c)
+	}
+
+	rows := make([][]string, len(items))
+	for ri, it := range items {
+		row := make([]string, len(cols))
+		for ci, c := range cols {
+			cell := formatCell(c, it[c])
+			row[ci] = cell
+			if len(cell) > widths[ci] {
+				widths[ci] = len(cell)
+			}
+		}
+		rows[ri] = row
+	}
+
+	writeRow := func(cells []string) {
+		for i, cell := range cells {
+			fmt.Fprintf(w, "%-*s  ", widths[i], cell)
+		}
+		fmt.Fprintln(w)
+	}
+
+	writeRow(cols)
+	for _, row := range rows {
+		writeRow(row)
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package output
+
+import (
+	"io"
+	"os"
+	"strings"
+)
+
+const fileOutputPrefix = "file:"
+
+// ResolveWriter turns an Options.Output spec into a writer. "file:path"
+// writes to that path on disk (created/truncated); anything else, including
+// the empty string, writes to stdout. The returned close func must be
+// called once writing is done; it's a no-op for stdout.
+func ResolveWriter(spec string) (io.Writer, func() error, error) {
+	path, ok := strings.CutPrefix(spec, fileOutputPrefix)
+	if !ok {
+		return os.Stdout, func() error { return nil }, nil
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, f.Close, nil
+}
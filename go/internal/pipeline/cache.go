@@ -0,0 +1,107 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"val/internal/adapters"
+	"val/internal/cache"
+	"val/internal/strategies"
+)
+
+// defaultCache backs adapter fetches and strategy evals unless
+// Options.Refresh bypasses it. Quote fields move faster than fundamentals,
+// so the mock adapter (the only built-in one today) gets a short TTL;
+// everything else falls back to cache.defaultTTL.
+var defaultCache = cache.New(cache.NewLRU(4096), map[string]time.Duration{
+	"mock": 5 * time.Minute,
+})
+
+// CacheMetrics reports the default cache's hit/miss/eviction counters, for
+// cmd/vald's /health endpoint.
+func CacheMetrics() cache.Metrics { return defaultCache.Metrics() }
+
+// cachedFetchOne is fetchOne with a cache lookup in front of it, keyed on
+// the adapter name, ticker, field set and day.
+func cachedFetchOne(ctx context.Context, ad adapters.Adapter, ticker string, timeout time.Duration, refresh bool) fetchResult {
+	if refresh {
+		return fetchOne(ctx, ad, ticker, timeout)
+	}
+
+	key := cache.Key(ad.Name(), ticker, ad.Fields(), time.Now())
+	raw, err := defaultCache.GetOrLoad(ad.Name(), key, func() ([]byte, error) {
+		res := fetchOne(ctx, ad, ticker, timeout)
+		if res.err != nil {
+			return nil, res.err
+		}
+		return json.Marshal(res.rows)
+	})
+	if err != nil {
+		return fetchResult{err: err}
+	}
+
+	var rows []adapters.Row
+	if err := json.Unmarshal(raw, &rows); err != nil {
+		return fetchResult{err: err}
+	}
+	return fetchResult{rows: rows}
+}
+
+// evalNamespace keys the eval cache separately from the fetch cache, since
+// the same ticker can be cached for multiple strategies at once.
+func evalNamespace(strategy string) string { return "eval:" + strategy }
+
+// cachedEvalOne is strategies.Eval for a single row with a cache lookup in
+// front of it, keyed like cachedFetchOne on the strategy, ticker, field set
+// and day. Routing through GetOrLoad (instead of a bare Get/Set pair) means
+// concurrent runs over overlapping tickers collapse into one upstream
+// strategies.Eval call per ticker, the same stampede protection
+// cachedFetchOne already gives adapter fetches.
+func cachedEvalOne(ctx context.Context, strategy string, row map[string]any, refresh bool) (strategies.EvalResult, error) {
+	ticker, _ := row["ticker"].(string)
+	if refresh {
+		return evalOne(ctx, strategy, row)
+	}
+
+	ns := evalNamespace(strategy)
+	key := cache.Key(ns, ticker, evalFields(row), time.Now())
+	raw, err := defaultCache.GetOrLoad(ns, key, func() ([]byte, error) {
+		ev, err := evalOne(ctx, strategy, row)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(ev)
+	})
+	if err != nil {
+		return strategies.EvalResult{}, err
+	}
+
+	var ev strategies.EvalResult
+	if err := json.Unmarshal(raw, &ev); err != nil {
+		return strategies.EvalResult{}, err
+	}
+	return ev, nil
+}
+
+// evalOne calls strategies.Eval for a single row and unwraps its one
+// expected result.
+func evalOne(ctx context.Context, strategy string, row map[string]any) (strategies.EvalResult, error) {
+	results, err := strategies.Eval(ctx, strategy, []map[string]any{row})
+	if err != nil {
+		return strategies.EvalResult{}, err
+	}
+	if len(results) == 0 {
+		return strategies.EvalResult{}, fmt.Errorf("no eval result for ticker %v", row["ticker"])
+	}
+	return results[0], nil
+}
+
+func evalFields(row map[string]any) []string {
+	fields := make([]string, 0, len(row))
+	for k := range row {
+		fields = append(fields, k)
+	}
+	return fields
+}
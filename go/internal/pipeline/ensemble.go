@@ -0,0 +1,61 @@
+package pipeline
+
+import (
+	"math"
+
+	"val/internal/strategies"
+)
+
+// ensembleValues is the confidence-weighted aggregate of a ticker's
+// per-strategy fair values, plus an agreement score.
+type ensembleValues struct {
+	FairValue float64
+	MOS       float64
+	Conf      float64
+	Agreement float64
+}
+
+// ensembleFor combines a ticker's per-strategy results into a single
+// confidence-weighted fair value (FV_ens = Σ(conf_i·FV_i) / Σconf_i), an
+// MOS derived from it, and an "agreement" dispersion score (stdev/mean of
+// the individual fair values) so callers can see when strategies disagree.
+func ensembleFor(results []strategies.EvalResult, price float64) ensembleValues {
+	var weightedSum, weightSum, sum float64
+	fvs := make([]float64, 0, len(results))
+	for _, r := range results {
+		weightedSum += r.Conf * r.FairValue
+		weightSum += r.Conf
+		sum += r.FairValue
+		fvs = append(fvs, r.FairValue)
+	}
+
+	n := float64(len(results))
+	fv := sum / n
+	if weightSum > 0 {
+		fv = weightedSum / weightSum
+	}
+
+	mean := sum / n
+	var variance float64
+	for _, v := range fvs {
+		d := v - mean
+		variance += d * d
+	}
+	variance /= n
+	agreement := 0.0
+	if mean != 0 {
+		agreement = math.Sqrt(variance) / mean
+	}
+
+	mos := 0.0
+	if fv > 0 {
+		mos = (fv - price) / fv
+	}
+
+	return ensembleValues{
+		FairValue: fv,
+		MOS:       mos,
+		Conf:      weightSum / n,
+		Agreement: agreement,
+	}
+}
@@ -0,0 +1,44 @@
+package pipeline
+
+import (
+	"context"
+	"time"
+
+	"val/internal/adapters"
+)
+
+// defaultMaxConcurrency bounds RunStream's per-ticker worker pool when
+// Options.MaxConcurrency isn't set.
+const defaultMaxConcurrency = 4
+
+type fetchResult struct {
+	rows []adapters.Row
+	err  error
+}
+
+func fetchOne(ctx context.Context, ad adapters.Adapter, ticker string, timeout time.Duration) fetchResult {
+	tctx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		tctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	ch, err := adapters.FetchCtx(tctx, ad, []string{ticker})
+	if err != nil {
+		return fetchResult{err: err}
+	}
+
+	var rows []adapters.Row
+	for {
+		select {
+		case r, ok := <-ch:
+			if !ok {
+				return fetchResult{rows: rows}
+			}
+			rows = append(rows, r)
+		case <-tctx.Done():
+			return fetchResult{rows: rows, err: tctx.Err()}
+		}
+	}
+}
@@ -1,8 +1,12 @@
 package pipeline
 
 import (
+	"context"
 	"errors"
+	"log"
 	"strings"
+	"sync"
+	"time"
 
 	"val/internal/adapters"
 	"val/internal/output"
@@ -10,70 +14,213 @@ import (
 )
 
 type Options struct {
-	Mode       output.Mode
-	Adapter    string
-	Strategy   string
-	TickersCSV string
+	Mode       output.Mode `json:"mode"`
+	Adapter    string      `json:"adapter"`
+	Strategy   string      `json:"strategy"`
+	TickersCSV string      `json:"tickersCSV"`
+	// Broker selects the broadcast sink's message broker ("nats", "stdout",
+	// "noop"). Only used when Mode is ModeBroadcast; defaults to "nats".
+	Broker string `json:"broker,omitempty"`
+	// Timeout bounds each adapter fetch and strategy evaluation call. Zero
+	// means no per-call deadline beyond ctx.
+	Timeout time.Duration `json:"timeout,omitempty"`
+	// MaxConcurrency caps how many tickers are fetched from the adapter at
+	// once. Zero uses defaultMaxConcurrency.
+	MaxConcurrency int `json:"maxConcurrency,omitempty"`
+	// Format selects the ConsoleSink formatter ("table", "json", "ndjson",
+	// "csv", "markdown"). Only used when Mode is ModeConsole; defaults to
+	// "table".
+	Format string `json:"format,omitempty"`
+	// Output selects where ConsoleSink writes. "file:path" writes to that
+	// path; anything else (including empty) writes to stdout.
+	Output string `json:"output,omitempty"`
+	// Refresh bypasses the cache for both adapter fetches and strategy
+	// evals, forcing a fresh upstream call.
+	Refresh bool `json:"refresh,omitempty"`
 }
 
-func Run(opts Options) error {
+// Run fetches, evaluates and publishes valuations for opts. ctx cancellation
+// (e.g. Ctrl-C on the CLI) stops in-flight adapter fetches and strategy
+// calls cleanly.
+func Run(ctx context.Context, opts Options) error {
+	var final []map[string]any
+	if err := RunStream(ctx, opts, func(row map[string]any) {
+		final = append(final, row)
+	}); err != nil {
+		return err
+	}
+
+	var sink output.Sink
+	switch opts.Mode {
+	case output.ModeConsole:
+		formatter, err := output.FormatterFor(opts.Format)
+		if err != nil {
+			return err
+		}
+		w, closeWriter, err := output.ResolveWriter(opts.Output)
+		if err != nil {
+			return err
+		}
+		defer closeWriter()
+		sink = output.NewConsoleSink(formatter, w)
+	case output.ModeBroadcast:
+		brokerName := opts.Broker
+		if brokerName == "" {
+			brokerName = "nats"
+		}
+		bs, err := output.NewBroadcastSink(brokerName)
+		if err != nil {
+			return err
+		}
+		sink = bs
+	case output.ModeGUI:
+		return errors.New("gui mode is served by cmd/vald's HTTP handlers, not this sink path")
+	default:
+		return errors.New("unknown mode")
+	}
+	return sink.Publish(final)
+}
+
+// RunStream behaves like Run but invokes onRow for each ticker's rows as
+// soon as that ticker's fetch and evals finish, instead of waiting for the
+// whole batch. The GUI's SSE handler relies on this to push rows to the
+// browser incrementally rather than in one burst at the end.
+//
+// Strategy may be a comma list ("dcf,graham,peg"): each is evaluated
+// per-ticker, and alongside each strategy's own row, a confidence-weighted
+// ensemble row is emitted once more than one strategy is given. onRow is
+// only ever called from one goroutine at a time, so it doesn't need to be
+// concurrency-safe itself.
+func RunStream(ctx context.Context, opts Options, onRow func(row map[string]any)) error {
 	ad, ok := adapters.Get(opts.Adapter)
 	if !ok {
 		return errors.New("adapter not found: " + opts.Adapter)
 	}
 	tickers := splitCSV(opts.TickersCSV)
-	raw, err := ad.Fetch(tickers)
-	if err != nil {
-		return err
+	strategyNames := splitCSV(opts.Strategy)
+	if len(strategyNames) == 0 {
+		return errors.New("no strategy specified")
 	}
 
-	evals, err := strategies.Eval(opts.Strategy, raw)
-	if err != nil {
-		return err
+	maxConcurrency := opts.MaxConcurrency
+	if maxConcurrency <= 0 {
+		maxConcurrency = defaultMaxConcurrency
+	}
+	if maxConcurrency > len(tickers) {
+		maxConcurrency = len(tickers)
+	}
+	if maxConcurrency == 0 {
+		return nil
 	}
 
-	fairByTicker := map[string]strategies.EvalResult{}
-	for _, e := range evals {
-		fairByTicker[e.Ticker] = e
+	tickerCh := make(chan string)
+	resultCh := make(chan []map[string]any, len(tickers))
+
+	var wg sync.WaitGroup
+	for i := 0; i < maxConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for t := range tickerCh {
+				resultCh <- tickerRows(ctx, ad, t, strategyNames, opts)
+			}
+		}()
 	}
 
-	final := make([]map[string]any, 0, len(raw))
-	for _, r := range raw {
-		t, _ := r["ticker"].(string)
-		price, _ := r["price"].(float64)
-		ev, ok := fairByTicker[t]
-		if !ok {
+	go func() {
+		defer close(tickerCh)
+		for _, t := range tickers {
+			select {
+			case tickerCh <- t:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(resultCh)
+	}()
+
+	for rows := range resultCh {
+		for _, row := range rows {
+			onRow(row)
+		}
+	}
+	return ctx.Err()
+}
+
+// tickerRows fetches and evaluates one ticker against every strategy,
+// returning its per-strategy rows plus an ensemble row when more than one
+// strategy was requested and at least one of them succeeded. A fetch or eval failure is logged and drops that
+// ticker (or that one strategy) from the result instead of failing the
+// whole run.
+func tickerRows(ctx context.Context, ad adapters.Adapter, ticker string, strategyNames []string, opts Options) []map[string]any {
+	fr := cachedFetchOne(ctx, ad, ticker, opts.Timeout, opts.Refresh)
+	if fr.err != nil {
+		log.Printf("pipeline: fetch failed for %s: %v", ticker, fr.err)
+		return nil
+	}
+
+	var rows []map[string]any
+	for _, row := range fr.rows {
+		t, _ := row["ticker"].(string)
+		if t == "" {
 			continue
 		}
-		fv := ev.FairValue
-		mos := 0.0
-		if fv > 0 {
-			mos = (fv - price) / fv
+		price, _ := row["price"].(float64)
+
+		ordered := make([]strategies.EvalResult, 0, len(strategyNames))
+		for _, name := range strategyNames {
+			ectx, cancel := ctx, context.CancelFunc(func() {})
+			if opts.Timeout > 0 {
+				ectx, cancel = context.WithTimeout(ctx, opts.Timeout)
+			}
+			ev, err := cachedEvalOne(ectx, name, row, opts.Refresh)
+			cancel()
+			if err != nil {
+				log.Printf("pipeline: eval failed for %s/%s: %v", t, name, err)
+				continue
+			}
+			rows = append(rows, buildStrategyRow(t, price, name, ev))
+			ordered = append(ordered, ev)
 		}
-		row := map[string]any{
-			"ticker":      t,
-			"price":       price,
-			"fair_value":  fv,
-			"mos":         mos,
-			"strategy":    opts.Strategy,
-			"notes":       ev.Notes,
-			"conf":        ev.Conf,
+
+		if len(strategyNames) > 1 && len(ordered) > 0 {
+			rows = append(rows, buildEnsembleRow(t, price, ordered))
 		}
-		final = append(final, row)
 	}
+	return rows
+}
 
-	var sink output.Sink
-	switch opts.Mode {
-	case output.ModeConsole:
-		sink = output.NewConsoleSink()
-	case output.ModeBroadcast:
-		return errors.New("broadcast mode not yet implemented")
-	case output.ModeGUI:
-		return errors.New("gui mode not yet implemented")
-	default:
-		return errors.New("unknown mode")
+func buildStrategyRow(ticker string, price float64, strategy string, ev strategies.EvalResult) map[string]any {
+	mos := 0.0
+	if ev.FairValue > 0 {
+		mos = (ev.FairValue - price) / ev.FairValue
+	}
+	return map[string]any{
+		"ticker":     ticker,
+		"price":      price,
+		"fair_value": ev.FairValue,
+		"mos":        mos,
+		"strategy":   strategy,
+		"notes":      ev.Notes,
+		"conf":       ev.Conf,
+	}
+}
+
+func buildEnsembleRow(ticker string, price float64, results []strategies.EvalResult) map[string]any {
+	e := ensembleFor(results, price)
+	return map[string]any{
+		"ticker":     ticker,
+		"price":      price,
+		"fair_value": e.FairValue,
+		"mos":        e.MOS,
+		"strategy":   "ensemble",
+		"conf":       e.Conf,
+		"agreement":  e.Agreement,
 	}
-	return sink.Publish(final)
 }
 
 func splitCSV(s string) []string {
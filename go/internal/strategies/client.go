@@ -1,36 +1,9 @@
+// Package strategies calls out to the external strategy service that turns
+// raw adapter rows into fair-value estimates. The RPC mechanism is
+// pluggable; see transport.go.
 package strategies
 
-import (
-	"bytes"
-	"encoding/json"
-	"fmt"
-	"net/http"
-	"os"
-	"time"
-)
-
-type evalItem struct {
-	Ticker string                 `json:"ticker"`
-	Data   map[string]any         `json:"data"`
-}
-type evalRequest struct {
-	Strategy string     `json:"strategy"`
-	Items    []evalItem `json:"items"`
-}
-
-type resultModel struct {
-	FairValue float64                `json:"fair_value"`
-	Inputs    map[string]any         `json:"inputs"`
-	Notes     string                 `json:"notes"`
-	Conf      float64                `json:"conf"`
-}
-type evalItemResp struct {
-	Ticker string      `json:"ticker"`
-	Result resultModel `json:"result"`
-}
-type evalResponse struct {
-	Items []evalItemResp `json:"items"`
-}
+import "context"
 
 type EvalResult struct {
 	Ticker    string
@@ -40,17 +13,19 @@ type EvalResult struct {
 	Conf      float64
 }
 
-func Eval(strategy string, rows []map[string]any) ([]EvalResult, error) {
-	baseURL := os.Getenv("STRAT_URL")
-	if baseURL == "" {
-		baseURL = "http://localhost:8000"
+// Eval evaluates rows against the named strategy using whichever transport
+// STRAT_PROTO selects (http by default). It honors ctx cancellation and
+// deadlines for the underlying RPC.
+func Eval(ctx context.Context, strategy string, rows []map[string]any) ([]EvalResult, error) {
+	t, err := selectedTransport()
+	if err != nil {
+		return nil, err
 	}
-	url := baseURL + "/eval"
 
 	items := make([]evalItem, 0, len(rows))
 	for _, r := range rows {
-		t, _ := r["ticker"].(string)
-		if t == "" {
+		ticker, _ := r["ticker"].(string)
+		if ticker == "" {
 			continue
 		}
 		data := map[string]any{}
@@ -60,41 +35,8 @@ func Eval(strategy string, rows []map[string]any) ([]EvalResult, error) {
 			}
 			data[k] = v
 		}
-		items = append(items, evalItem{Ticker: t, Data: data})
-	}
-
-	req := evalRequest{Strategy: strategy, Items: items}
-	body, err := json.Marshal(req)
-	if err != nil {
-		return nil, err
+		items = append(items, evalItem{Ticker: ticker, Data: data})
 	}
 
-	httpClient := &http.Client{Timeout: 10 * time.Second}
-	resp, err := httpClient.Post(url, "application/json", bytes.NewReader(body))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-	if resp.StatusCode >= 300 {
-		var m map[string]any
-		_ = json.NewDecoder(resp.Body).Decode(&m)
-		return nil, fmt.Errorf("strategy service error %d: %v", resp.StatusCode, m)
-	}
-
-	var out evalResponse
-	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
-		return nil, err
-	}
-
-	results := make([]EvalResult, 0, len(out.Items))
-	for _, it := range out.Items {
-		results = append(results, EvalResult{
-			Ticker:    it.Ticker,
-			FairValue: it.Result.FairValue,
-			Inputs:    it.Result.Inputs,
-			Notes:     it.Result.Notes,
-			Conf:      it.Result.Conf,
-		})
-	}
-	return results, nil
+	return t.Eval(ctx, strategy, items)
 }
@@ -0,0 +1,164 @@
+package strategies
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	pb "val/internal/strategies/proto"
+)
+
+// grpcStreamThreshold is the batch size above which the gRPC transport uses
+// the streaming RPC instead of the unary one, so the server sends one message
+// per item rather than a single large response. Eval still collects every
+// streamed item before it returns.
+const grpcStreamThreshold = 200
+
+func grpcTarget() string {
+	if v := os.Getenv("STRAT_GRPC_URL"); v != "" {
+		return v
+	}
+	return "localhost:9000"
+}
+
+// grpcTransport talks to the strategy service over gRPC, using the schema
+// in proto/eval.proto. target and dialOpts default to the production
+// dial-by-env-var behavior but are overridable so tests can point the
+// transport at a bufconn listener.
+type grpcTransport struct {
+	target   string
+	dialOpts []grpc.DialOption
+}
+
+func newGRPCTransport() *grpcTransport {
+	return &grpcTransport{
+		dialOpts: []grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithBlock(),
+		},
+	}
+}
+
+func (g *grpcTransport) Name() string { return "grpc" }
+
+func (g *grpcTransport) Eval(ctx context.Context, strategy string, items []evalItem) ([]EvalResult, error) {
+	req, err := toProtoRequest(strategy, items)
+	if err != nil {
+		return nil, err
+	}
+
+	target := g.target
+	if target == "" {
+		target = grpcTarget()
+	}
+
+	var results []EvalResult
+	err = withRetry(ctx, defaultRetries, defaultBackoff, func() error {
+		callCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+		defer cancel()
+
+		conn, err := grpc.DialContext(callCtx, target, g.dialOpts...)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		client := pb.NewEvalServiceClient(conn)
+		callOpt := grpc.CallContentSubtype(pb.CodecName)
+		if len(items) > grpcStreamThreshold {
+			r, err := evalStream(callCtx, client, req, callOpt)
+			if err != nil {
+				return err
+			}
+			results = r
+			return nil
+		}
+
+		resp, err := client.Eval(callCtx, req, callOpt)
+		if err != nil {
+			return err
+		}
+		r, err := fromProtoResponse(resp)
+		if err != nil {
+			return err
+		}
+		results = r
+		return nil
+	})
+	return results, err
+}
+
+func evalStream(ctx context.Context, client pb.EvalServiceClient, req *pb.EvalRequest, opts ...grpc.CallOption) ([]EvalResult, error) {
+	stream, err := client.EvalStream(ctx, req, opts...)
+	if err != nil {
+		return nil, err
+	}
+	var results []EvalResult
+	for {
+		item, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		r, err := fromProtoItem(item)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, r)
+	}
+	return results, nil
+}
+
+func toProtoRequest(strategy string, items []evalItem) (*pb.EvalRequest, error) {
+	pbItems := make([]*pb.EvalItem, 0, len(items))
+	for _, it := range items {
+		dataJSON, err := json.Marshal(it.Data)
+		if err != nil {
+			return nil, err
+		}
+		pbItems = append(pbItems, &pb.EvalItem{Ticker: it.Ticker, DataJson: dataJSON})
+	}
+	return &pb.EvalRequest{Strategy: strategy, Items: pbItems}, nil
+}
+
+func fromProtoResponse(resp *pb.EvalResponse) ([]EvalResult, error) {
+	results := make([]EvalResult, 0, len(resp.Items))
+	for _, it := range resp.Items {
+		r, err := fromProtoItem(it)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, r)
+	}
+	return results, nil
+}
+
+func fromProtoItem(it *pb.EvalItemResponse) (EvalResult, error) {
+	if it.Result == nil {
+		return EvalResult{Ticker: it.Ticker}, nil
+	}
+
+	var inputs map[string]any
+	if len(it.Result.InputsJson) > 0 {
+		if err := json.Unmarshal(it.Result.InputsJson, &inputs); err != nil {
+			return EvalResult{}, err
+		}
+	}
+	return EvalResult{
+		Ticker:    it.Ticker,
+		FairValue: it.Result.FairValue,
+		Inputs:    inputs,
+		Notes:     it.Result.Notes,
+		Conf:      it.Result.Conf,
+	}, nil
+}
+
+func init() {
+	RegisterTransport(newGRPCTransport())
+}
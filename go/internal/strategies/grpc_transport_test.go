@@ -0,0 +1,129 @@
+package strategies
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	pb "val/internal/strategies/proto"
+)
+
+// fakeEvalServer is a minimal in-memory EvalServiceServer used to exercise
+// the gRPC transport without a real strategy service. It echoes back a
+// fixed fair value per ticker so tests can assert on shape, not a specific
+// valuation.
+type fakeEvalServer struct {
+	pb.EvalServiceServer // embed so we satisfy the interface even if it grows
+
+	// omitResult makes Eval/EvalStream return an item with a nil Result, to
+	// exercise fromProtoItem's nil guard.
+	omitResult bool
+}
+
+func (f *fakeEvalServer) Eval(ctx context.Context, req *pb.EvalRequest) (*pb.EvalResponse, error) {
+	resp := &pb.EvalResponse{}
+	for _, it := range req.Items {
+		resp.Items = append(resp.Items, f.respond(it))
+	}
+	return resp, nil
+}
+
+func (f *fakeEvalServer) EvalStream(req *pb.EvalRequest, stream pb.EvalService_EvalStreamServer) error {
+	for _, it := range req.Items {
+		if err := stream.Send(f.respond(it)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fakeEvalServer) respond(it *pb.EvalItem) *pb.EvalItemResponse {
+	if f.omitResult {
+		return &pb.EvalItemResponse{Ticker: it.Ticker}
+	}
+	inputs, _ := json.Marshal(map[string]any{"seen": string(it.DataJson)})
+	return &pb.EvalItemResponse{
+		Ticker: it.Ticker,
+		Result: &pb.EvalResultModel{
+			FairValue:  42,
+			InputsJson: inputs,
+			Notes:      "fake",
+			Conf:       0.9,
+		},
+	}
+}
+
+// dialFakeServer starts srv on an in-memory bufconn listener and returns a
+// grpcTransport wired to dial it, plus a cleanup func.
+func dialFakeServer(t *testing.T, srv pb.EvalServiceServer) (*grpcTransport, func()) {
+	t.Helper()
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	pb.RegisterEvalServiceServer(s, srv)
+	go s.Serve(lis)
+
+	gt := &grpcTransport{
+		target: "bufconn",
+		dialOpts: []grpc.DialOption{
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return lis.DialContext(ctx)
+			}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithBlock(),
+		},
+	}
+	return gt, func() { s.Stop() }
+}
+
+func TestGRPCTransportEval(t *testing.T) {
+	gt, stop := dialFakeServer(t, &fakeEvalServer{})
+	defer stop()
+
+	items := []evalItem{{Ticker: "AAPL", Data: map[string]any{"price": 100.0}}}
+	results, err := gt.Eval(context.Background(), "dcf", items)
+	if err != nil {
+		t.Fatalf("Eval: %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("got %d results, want 1", len(results))
+	}
+	if results[0].Ticker != "AAPL" || results[0].FairValue != 42 || results[0].Notes != "fake" {
+		t.Fatalf("unexpected result: %+v", results[0])
+	}
+}
+
+func TestGRPCTransportEvalStream(t *testing.T) {
+	gt, stop := dialFakeServer(t, &fakeEvalServer{})
+	defer stop()
+
+	items := make([]evalItem, grpcStreamThreshold+1)
+	for i := range items {
+		items[i] = evalItem{Ticker: "T", Data: map[string]any{}}
+	}
+	results, err := gt.Eval(context.Background(), "dcf", items)
+	if err != nil {
+		t.Fatalf("Eval (stream): %v", err)
+	}
+	if len(results) != len(items) {
+		t.Fatalf("got %d results, want %d", len(results), len(items))
+	}
+}
+
+func TestGRPCTransportNilResult(t *testing.T) {
+	gt, stop := dialFakeServer(t, &fakeEvalServer{omitResult: true})
+	defer stop()
+
+	items := []evalItem{{Ticker: "NIL", Data: map[string]any{}}}
+	results, err := gt.Eval(context.Background(), "dcf", items)
+	if err != nil {
+		t.Fatalf("Eval: %v", err)
+	}
+	if len(results) != 1 || results[0].Ticker != "NIL" || results[0].FairValue != 0 {
+		t.Fatalf("unexpected result for nil-result item: %+v", results)
+	}
+}
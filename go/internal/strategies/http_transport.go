@@ -0,0 +1,100 @@
+package strategies
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+)
+
+type httpEvalItem struct {
+	Ticker string         `json:"ticker"`
+	Data   map[string]any `json:"data"`
+}
+type httpEvalRequest struct {
+	Strategy string         `json:"strategy"`
+	Items    []httpEvalItem `json:"items"`
+}
+
+type httpResultModel struct {
+	FairValue float64        `json:"fair_value"`
+	Inputs    map[string]any `json:"inputs"`
+	Notes     string         `json:"notes"`
+	Conf      float64        `json:"conf"`
+}
+type httpEvalItemResp struct {
+	Ticker string          `json:"ticker"`
+	Result httpResultModel `json:"result"`
+}
+type httpEvalResponse struct {
+	Items []httpEvalItemResp `json:"items"`
+}
+
+// httpTransport is the original JSON-over-HTTP transport, now implemented
+// behind the Transport interface.
+type httpTransport struct {
+	client *http.Client
+}
+
+func (h *httpTransport) Name() string { return "http" }
+
+func httpBaseURL() string {
+	baseURL := os.Getenv("STRAT_URL")
+	if baseURL == "" {
+		baseURL = "http://localhost:8000"
+	}
+	return baseURL
+}
+
+func (h *httpTransport) Eval(ctx context.Context, strategy string, items []evalItem) ([]EvalResult, error) {
+	reqItems := make([]httpEvalItem, 0, len(items))
+	for _, it := range items {
+		reqItems = append(reqItems, httpEvalItem{Ticker: it.Ticker, Data: it.Data})
+	}
+	body, err := json.Marshal(httpEvalRequest{Strategy: strategy, Items: reqItems})
+	if err != nil {
+		return nil, err
+	}
+
+	var out httpEvalResponse
+	err = withRetry(ctx, defaultRetries, defaultBackoff, func() error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, httpBaseURL()+"/eval", bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := h.client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode >= 300 {
+			var m map[string]any
+			_ = json.NewDecoder(resp.Body).Decode(&m)
+			return fmt.Errorf("strategy service error %d: %v", resp.StatusCode, m)
+		}
+		out = httpEvalResponse{}
+		return json.NewDecoder(resp.Body).Decode(&out)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]EvalResult, 0, len(out.Items))
+	for _, it := range out.Items {
+		results = append(results, EvalResult{
+			Ticker:    it.Ticker,
+			FairValue: it.Result.FairValue,
+			Inputs:    it.Result.Inputs,
+			Notes:     it.Result.Notes,
+			Conf:      it.Result.Conf,
+		})
+	}
+	return results, nil
+}
+
+func init() {
+	RegisterTransport(&httpTransport{client: &http.Client{Timeout: defaultTimeout}})
+}
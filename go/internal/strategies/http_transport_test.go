@@ -0,0 +1,57 @@
+package strategies
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// fakeHTTPServer starts an httptest server that answers /eval with the same
+// fair value, notes and conf as fakeEvalServer (Inputs echoes the request data
+// as a map rather than as JSON bytes), so the http and grpc transports can be
+// compared against equivalent fakes in transport_parity_test.go.
+func fakeHTTPServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req httpEvalRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp := httpEvalResponse{}
+		for _, it := range req.Items {
+			resp.Items = append(resp.Items, httpEvalItemResp{
+				Ticker: it.Ticker,
+				Result: httpResultModel{
+					FairValue: 42,
+					Inputs:    map[string]any{"seen": it.Data},
+					Notes:     "fake",
+					Conf:      0.9,
+				},
+			})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func TestHTTPTransportEval(t *testing.T) {
+	srv := fakeHTTPServer(t)
+	defer srv.Close()
+	t.Setenv("STRAT_URL", srv.URL)
+
+	h := &httpTransport{client: srv.Client()}
+	items := []evalItem{{Ticker: "AAPL", Data: map[string]any{"price": 100.0}}}
+	results, err := h.Eval(context.Background(), "dcf", items)
+	if err != nil {
+		t.Fatalf("Eval: %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("got %d results, want 1", len(results))
+	}
+	if results[0].Ticker != "AAPL" || results[0].FairValue != 42 || results[0].Notes != "fake" {
+		t.Fatalf("unexpected result: %+v", results[0])
+	}
+}
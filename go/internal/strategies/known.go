@@ -0,0 +1,7 @@
+package strategies
+
+// KnownStrategies lists the strategy identifiers the strategy service is
+// expected to support. It's indicative only — the service is the source of
+// truth for what's actually valid — and exists so the GUI's discovery
+// endpoint has something to populate a picker with.
+var KnownStrategies = []string{"dcf", "graham", "peg"}
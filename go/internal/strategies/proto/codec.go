@@ -0,0 +1,24 @@
+package proto
+
+import (
+	"encoding/json"
+
+	"google.golang.org/grpc/encoding"
+)
+
+// CodecName is the gRPC content-subtype the EvalService messages are sent
+// under. Callers select it with grpc.CallContentSubtype(CodecName).
+const CodecName = "valjson"
+
+// jsonCodec marshals the plain structs in this package as JSON. It exists
+// because those structs don't implement proto.Message, so grpc-go's
+// default codec (which requires it) can't carry them.
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v any) ([]byte, error)      { return json.Marshal(v) }
+func (jsonCodec) Unmarshal(data []byte, v any) error { return json.Unmarshal(data, v) }
+func (jsonCodec) Name() string                       { return CodecName }
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
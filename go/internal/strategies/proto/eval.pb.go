@@ -0,0 +1,110 @@
+// Wire types for eval.proto.
+//
+// These are hand-maintained, not protoc-generated: they don't implement
+// proto.Message (no Reset/String/ProtoReflect), so they can't go through
+// grpc-go's default protobuf codec. They're sent over the wire using the
+// JSON codec registered in codec.go instead — see that file for why.
+package proto
+
+type EvalItem struct {
+	Ticker   string `json:"ticker,omitempty"`
+	DataJson []byte `json:"data_json,omitempty"`
+}
+
+func (x *EvalItem) GetTicker() string {
+	if x != nil {
+		return x.Ticker
+	}
+	return ""
+}
+
+func (x *EvalItem) GetDataJson() []byte {
+	if x != nil {
+		return x.DataJson
+	}
+	return nil
+}
+
+type EvalRequest struct {
+	Strategy string      `json:"strategy,omitempty"`
+	Items    []*EvalItem `json:"items,omitempty"`
+}
+
+func (x *EvalRequest) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *EvalRequest) GetItems() []*EvalItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type EvalResultModel struct {
+	FairValue  float64 `json:"fair_value,omitempty"`
+	InputsJson []byte  `json:"inputs_json,omitempty"`
+	Notes      string  `json:"notes,omitempty"`
+	Conf       float64 `json:"conf,omitempty"`
+}
+
+func (x *EvalResultModel) GetFairValue() float64 {
+	if x != nil {
+		return x.FairValue
+	}
+	return 0
+}
+
+func (x *EvalResultModel) GetInputsJson() []byte {
+	if x != nil {
+		return x.InputsJson
+	}
+	return nil
+}
+
+func (x *EvalResultModel) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *EvalResultModel) GetConf() float64 {
+	if x != nil {
+		return x.Conf
+	}
+	return 0
+}
+
+type EvalItemResponse struct {
+	Ticker string           `json:"ticker,omitempty"`
+	Result *EvalResultModel `json:"result,omitempty"`
+}
+
+func (x *EvalItemResponse) GetTicker() string {
+	if x != nil {
+		return x.Ticker
+	}
+	return ""
+}
+
+func (x *EvalItemResponse) GetResult() *EvalResultModel {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type EvalResponse struct {
+	Items []*EvalItemResponse `json:"items,omitempty"`
+}
+
+func (x *EvalResponse) GetItems() []*EvalItemResponse {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+// Client and server stubs for eval.proto's EvalService.
+//
+// Hand-maintained alongside eval.pb.go rather than protoc-generated, for
+// the same reason: no proto.Message descriptors to generate against. The
+// wire format is whatever codec.go's jsonCodec produces, not the protobuf
+// wire format these stubs would imply if generated for real.
+package proto
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// EvalServiceClient is the client API for EvalService.
+type EvalServiceClient interface {
+	Eval(ctx context.Context, in *EvalRequest, opts ...grpc.CallOption) (*EvalResponse, error)
+	EvalStream(ctx context.Context, in *EvalRequest, opts ...grpc.CallOption) (EvalService_EvalStreamClient, error)
+}
+
+type evalServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEvalServiceClient(cc grpc.ClientConnInterface) EvalServiceClient {
+	return &evalServiceClient{cc}
+}
+
+func (c *evalServiceClient) Eval(ctx context.Context, in *EvalRequest, opts ...grpc.CallOption) (*EvalResponse, error) {
+	out := new(EvalResponse)
+	err := c.cc.Invoke(ctx, "/val.strategies.EvalService/Eval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evalServiceClient) EvalStream(ctx context.Context, in *EvalRequest, opts ...grpc.CallOption) (EvalService_EvalStreamClient, error) {
+	stream, err := c.cc.(grpc.ClientConnInterface).NewStream(ctx, &_EvalService_serviceDesc.Streams[0], "/val.strategies.EvalService/EvalStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &evalServiceEvalStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// EvalService_EvalStreamClient is the client-side stream handle returned by
+// EvalStream.
+type EvalService_EvalStreamClient interface {
+	Recv() (*EvalItemResponse, error)
+	grpc.ClientStream
+}
+
+type evalServiceEvalStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *evalServiceEvalStreamClient) Recv() (*EvalItemResponse, error) {
+	m := new(EvalItemResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EvalServiceServer is the server API for EvalService.
+type EvalServiceServer interface {
+	Eval(context.Context, *EvalRequest) (*EvalResponse, error)
+	EvalStream(*EvalRequest, EvalService_EvalStreamServer) error
+}
+
+// EvalService_EvalStreamServer is the server-side stream handle for
+// EvalStream.
+type EvalService_EvalStreamServer interface {
+	Send(*EvalItemResponse) error
+	grpc.ServerStream
+}
+
+func _EvalService_Eval_Handler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(EvalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvalServiceServer).Eval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/val.strategies.EvalService/Eval",
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(EvalServiceServer).Eval(ctx, req.(*EvalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EvalService_EvalStream_Handler(srv any, stream grpc.ServerStream) error {
+	in := new(EvalRequest)
+	if err := stream.RecvMsg(in); err != nil {
+		return err
+	}
+	return srv.(EvalServiceServer).EvalStream(in, &evalServiceEvalStreamServer{stream})
+}
+
+type evalServiceEvalStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *evalServiceEvalStreamServer) Send(m *EvalItemResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _EvalService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "val.strategies.EvalService",
+	HandlerType: (*EvalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "Eval", Handler: _EvalService_Eval_Handler},
+	},
+	Streams: []grpc.StreamDesc{
+		{StreamName: "EvalStream", ServerStreams: true, Handler: _EvalService_EvalStream_Handler},
+	},
+	Metadata: "eval.proto",
+}
+
+// RegisterEvalServiceServer registers srv on s under the EvalService
+// service descriptor.
+func RegisterEvalServiceServer(s grpc.ServiceRegistrar, srv EvalServiceServer) {
+	s.RegisterService(&_EvalService_serviceDesc, srv)
+}
@@ -0,0 +1,73 @@
+package strategies
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+)
+
+// evalItem is the transport-agnostic request unit: a ticker plus whatever
+// fields the adapter fetched for it. Each Transport is responsible for
+// encoding this into its own wire format.
+type evalItem struct {
+	Ticker string
+	Data   map[string]any
+}
+
+// Transport performs a strategy evaluation call against the strategy
+// service, independent of the RPC mechanism used to reach it. Implementations
+// are registered with RegisterTransport and selected via STRAT_PROTO.
+type Transport interface {
+	Name() string
+	Eval(ctx context.Context, strategy string, items []evalItem) ([]EvalResult, error)
+}
+
+var transports = map[string]Transport{}
+
+// RegisterTransport makes a Transport available for selection via
+// STRAT_PROTO, mirroring adapters.Register.
+func RegisterTransport(t Transport) { transports[t.Name()] = t }
+
+func selectedTransport() (Transport, error) {
+	name := os.Getenv("STRAT_PROTO")
+	if name == "" {
+		name = "http"
+	}
+	t, ok := transports[name]
+	if !ok {
+		return nil, fmt.Errorf("strategy transport not found: %s", name)
+	}
+	return t, nil
+}
+
+// Timeout, retry and backoff settings shared by every transport so switching
+// STRAT_PROTO doesn't change failure behavior.
+const (
+	defaultTimeout = 10 * time.Second
+	defaultRetries = 2
+	defaultBackoff = 250 * time.Millisecond
+)
+
+// withRetry calls fn up to attempts times with exponential backoff between
+// tries, returning the last error if every attempt fails. It stops early if
+// ctx is canceled between attempts.
+func withRetry(ctx context.Context, attempts int, backoff time.Duration, fn func() error) error {
+	var err error
+	for i := 0; i < attempts; i++ {
+		if err = fn(); err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if i < attempts-1 {
+			select {
+			case <-time.After(backoff * time.Duration(int64(1)<<uint(i))):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+	return err
+}
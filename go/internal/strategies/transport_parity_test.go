@@ -0,0 +1,41 @@
+package strategies
+
+import (
+	"context"
+	"testing"
+)
+
+// TestTransportParity checks that the http and grpc transports, hitting
+// equivalent fakes, agree on the fields that don't depend on each
+// transport's own wire encoding of the request data (Inputs is excluded:
+// grpc carries it as opaque JSON bytes while http carries the native map,
+// so the two fakes don't echo it identically).
+func TestTransportParity(t *testing.T) {
+	items := []evalItem{{Ticker: "AAPL", Data: map[string]any{"price": 100.0}}}
+
+	httpSrv := fakeHTTPServer(t)
+	defer httpSrv.Close()
+	t.Setenv("STRAT_URL", httpSrv.URL)
+	httpTr := &httpTransport{client: httpSrv.Client()}
+	httpResults, err := httpTr.Eval(context.Background(), "dcf", items)
+	if err != nil {
+		t.Fatalf("http Eval: %v", err)
+	}
+
+	grpcTr, stop := dialFakeServer(t, &fakeEvalServer{})
+	defer stop()
+	grpcResults, err := grpcTr.Eval(context.Background(), "dcf", items)
+	if err != nil {
+		t.Fatalf("grpc Eval: %v", err)
+	}
+
+	if len(httpResults) != len(grpcResults) {
+		t.Fatalf("result count mismatch: http=%d grpc=%d", len(httpResults), len(grpcResults))
+	}
+	for i := range httpResults {
+		h, g := httpResults[i], grpcResults[i]
+		if h.Ticker != g.Ticker || h.FairValue != g.FairValue || h.Notes != g.Notes || h.Conf != g.Conf {
+			t.Fatalf("transport mismatch at %d: http=%+v grpc=%+v", i, h, g)
+		}
+	}
+}